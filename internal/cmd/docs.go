@@ -0,0 +1,129 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/docs"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+
+	"github.com/mitchellh/cli"
+)
+
+type DocsCommand struct {
+	UI             cli.Ui
+	flagIrPath     string
+	flagTemplates  string
+	flagOutputPath string
+}
+
+func (cmd *DocsCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("docs", flag.ExitOnError)
+	fs.StringVar(&cmd.flagIrPath, "ir", "", "path to a previously generated Framework IR file (JSON); if unset, IR is read from stdin")
+	fs.StringVar(&cmd.flagTemplates, "templates", "", "path to a directory of Go text/template overrides for doc pages")
+	fs.StringVar(&cmd.flagOutputPath, "output", "./docs", "path to output directory for generated Markdown doc pages")
+	return fs
+}
+
+func (cmd *DocsCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-openapi docs [<args>]\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *DocsCommand) Synopsis() string {
+	return "Generates Markdown reference docs from a Framework IR file"
+}
+
+func (cmd *DocsCommand) Run(args []string) int {
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("unable to parse flags: %s", err))
+		return 1
+	}
+
+	err = cmd.runInternal()
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	return 0
+}
+
+func (cmd *DocsCommand) runInternal() error {
+	// 1. Read Framework IR, either from a file (--ir) or stdin, so docs can
+	// be generated from freshly produced `generate` output without an
+	// intermediate file.
+	var irBytes []byte
+	var err error
+	if cmd.flagIrPath != "" {
+		irBytes, err = os.ReadFile(cmd.flagIrPath)
+		if err != nil {
+			return fmt.Errorf("failed to read Framework IR file: %w", err)
+		}
+	} else {
+		irBytes, err = io.ReadAll(os.Stdin)
+		if err != nil {
+			return fmt.Errorf("failed to read Framework IR from stdin: %w", err)
+		}
+	}
+
+	var specification spec.Specification
+	if err := json.Unmarshal(irBytes, &specification); err != nil {
+		return fmt.Errorf("failed to parse Framework IR: %w", err)
+	}
+
+	// 2. Load default doc templates, layering any user overrides on top
+	renderer, err := docs.NewRenderer(cmd.flagTemplates)
+	if err != nil {
+		return fmt.Errorf("failed to load doc templates: %w", err)
+	}
+
+	// 3. Render provider, resource, and data source pages to --output
+	if err := docs.WriteAll(renderer, &specification, cmd.flagOutputPath); err != nil {
+		return fmt.Errorf("failed to render doc pages: %w", err)
+	}
+
+	return nil
+}
@@ -13,8 +13,10 @@ import (
 	"strings"
 
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/example"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
 	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/output"
 	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
 
 	"github.com/mitchellh/cli"
@@ -22,16 +24,24 @@ import (
 )
 
 type GenerateCommand struct {
-	UI             cli.Ui
-	oasInputPath   string
-	flagConfigPath string
-	flagOutputPath string
+	UI                 cli.Ui
+	oasInputPath       string
+	flagConfigPath     string
+	flagOutputPath     string
+	flagExamplesDir    string
+	flagReferencesPath string
+	flagSplit          bool
+	flagIncremental    bool
 }
 
 func (cmd *GenerateCommand) Flags() *flag.FlagSet {
 	fs := flag.NewFlagSet("generate", flag.ExitOnError)
 	fs.StringVar(&cmd.flagConfigPath, "config", "./tfopenapigen_config.yml", "path to config file (YAML)")
-	fs.StringVar(&cmd.flagOutputPath, "output", "", "path to output generated Framework IR file (JSON)")
+	fs.StringVar(&cmd.flagOutputPath, "output", "", "path to output generated Framework IR file (JSON), or a directory when --split is set")
+	fs.StringVar(&cmd.flagExamplesDir, "examples-dir", "", "path to output a sample .tf file per generated resource and data source")
+	fs.StringVar(&cmd.flagReferencesPath, "references", "", "path to a YAML file declaring cross-resource attribute references, used when generating examples")
+	fs.BoolVar(&cmd.flagSplit, "split", false, "write the Framework IR as one file per resource/data source plus an index.json manifest under --output, instead of a single JSON file")
+	fs.BoolVar(&cmd.flagIncremental, "incremental", false, "with --split, only rewrite files whose Framework IR content changed since the prior run's index.json")
 	return fs
 }
 
@@ -139,24 +149,48 @@ func (cmd *GenerateCommand) runInternal() error {
 		return err
 	}
 
-	// 5. Use framework IR to create JSON
+	// 5. Generate sample .tf examples for each resource/data source, if requested
+	if cmd.flagExamplesDir != "" {
+		refs, err := example.LoadReferenceMap(cmd.flagReferencesPath)
+		if err != nil {
+			return fmt.Errorf("error loading references file: %w", err)
+		}
+
+		if err := example.WriteAll(frameworkIr, refs, cmd.flagExamplesDir); err != nil {
+			return fmt.Errorf("error generating example manifests: %w", err)
+		}
+	}
+
+	// 6. When --split is set, write one file per resource/data source/function
+	// plus an index.json manifest, instead of a single JSON file.
+	if cmd.flagSplit {
+		if cmd.flagOutputPath == "" {
+			return errors.New("--split requires --output to name a directory")
+		}
+		if err := output.WriteSplit(frameworkIr, cmd.flagOutputPath, cmd.flagIncremental); err != nil {
+			return fmt.Errorf("error writing split Framework IR: %w", err)
+		}
+		return nil
+	}
+
+	// 7. Use framework IR to create JSON
 	bytes, err := json.MarshalIndent(frameworkIr, "", "\t")
 	if err != nil {
 		return fmt.Errorf("error marshalling Framework IR to JSON: %w", err)
 	}
 
-	// 6. Output to STDOUT or file
+	// 8. Output to STDOUT or file
 	if cmd.flagOutputPath == "" {
 		cmd.UI.Output(string(bytes))
 		return nil
 	}
 
-	output, err := os.Create(cmd.flagOutputPath)
+	outputFile, err := os.Create(cmd.flagOutputPath)
 	if err != nil {
 		return fmt.Errorf("error creating output file for Framework IR: %w", err)
 	}
 
-	_, err = output.Write(bytes)
+	_, err = outputFile.Write(bytes)
 	if err != nil {
 		return fmt.Errorf("error writing framework IR to output: %w", err)
 	}
@@ -190,6 +224,13 @@ func generateFrameworkIr(dora explorer.Explorer, cfg config.Config) (*spec.Speci
 		return nil, fmt.Errorf("error generating Framework IR for resources: %w", err)
 	}
 
+	// 4a. Apply any registered ResourceConfig overrides (late-init exclusions,
+	// extra sensitive marking, type overrides, defaults, references) now that
+	// resources are mapped but before anything is marshalled
+	if err := config.ApplyResourceOverrides(resourcesIR, cfg.ResourceConfigs()); err != nil {
+		return nil, fmt.Errorf("error applying resource config overrides: %w", err)
+	}
+
 	// 5. Use TF info to generate framework IR for data sources
 	dataSourceMapper := mapper.NewDataSourceMapper(explorerDataSources, cfg)
 	dataSourcesIR, err := dataSourceMapper.MapToIR()
@@ -204,9 +245,22 @@ func generateFrameworkIr(dora explorer.Explorer, cfg config.Config) (*spec.Speci
 		return nil, fmt.Errorf("error generating Framework IR for provider: %w", err)
 	}
 
+	// 7. Find and map TF provider-defined functions
+	explorerFunctions, err := dora.FindFunctions()
+	if err != nil {
+		return nil, fmt.Errorf("error finding functions: %w", err)
+	}
+
+	functionMapper := mapper.NewFunctionMapper(explorerFunctions, cfg)
+	functionsIR, err := functionMapper.MapToIR()
+	if err != nil {
+		return nil, fmt.Errorf("error generating Framework IR for functions: %w", err)
+	}
+
 	return &spec.Specification{
 		Provider:    providerIR,
 		Resources:   resourcesIR,
 		DataSources: dataSourcesIR,
+		Functions:   functionsIR,
 	}, nil
 }
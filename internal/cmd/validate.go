@@ -0,0 +1,203 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diag"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/mapper"
+
+	"github.com/mitchellh/cli"
+	"github.com/pb33f/libopenapi"
+)
+
+type ValidateCommand struct {
+	UI             cli.Ui
+	oasInputPath   string
+	flagConfigPath string
+	flagJSON       bool
+}
+
+func (cmd *ValidateCommand) Flags() *flag.FlagSet {
+	fs := flag.NewFlagSet("validate", flag.ExitOnError)
+	fs.StringVar(&cmd.flagConfigPath, "config", "./tfopenapigen_config.yml", "path to config file (YAML)")
+	fs.BoolVar(&cmd.flagJSON, "json", false, "emit diagnostics as a JSON stream instead of human-readable text")
+	return fs
+}
+
+func (cmd *ValidateCommand) Help() string {
+	strBuilder := &strings.Builder{}
+
+	longestName := 0
+	longestUsage := 0
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if len(f.Name) > longestName {
+			longestName = len(f.Name)
+		}
+		if len(f.Usage) > longestUsage {
+			longestUsage = len(f.Usage)
+		}
+	})
+
+	strBuilder.WriteString("\nUsage: tfplugingen-openapi validate [<args>] </path/to/oas_file.yml>\n\n")
+	cmd.Flags().VisitAll(func(f *flag.Flag) {
+		if f.DefValue != "" {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s  (default: %q)\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+				f.DefValue,
+			))
+		} else {
+			strBuilder.WriteString(fmt.Sprintf("    --%s <ARG> %s%s%s\n",
+				f.Name,
+				strings.Repeat(" ", longestName-len(f.Name)+2),
+				f.Usage,
+				strings.Repeat(" ", longestUsage-len(f.Usage)+2),
+			))
+		}
+	})
+	strBuilder.WriteString("\n")
+
+	return strBuilder.String()
+}
+
+func (cmd *ValidateCommand) Synopsis() string {
+	return "Lints a generator config and OpenAPI spec, reporting diagnostics without generating Framework IR"
+}
+
+func (cmd *ValidateCommand) Run(args []string) int {
+	fs := cmd.Flags()
+	err := fs.Parse(args)
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("unable to parse flags: %s", err))
+		return 1
+	}
+
+	cmd.oasInputPath = fs.Arg(0)
+	if cmd.oasInputPath == "" {
+		cmd.UI.Error("Error executing command: OpenAPI specification file is required as last argument")
+		return 1
+	}
+
+	diagnostics, err := cmd.runInternal()
+	if err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error executing command: %s\n", err))
+		return 1
+	}
+
+	if err := cmd.output(diagnostics); err != nil {
+		cmd.UI.Error(fmt.Sprintf("Error writing diagnostics: %s\n", err))
+		return 1
+	}
+
+	if diagnostics.HasErrors() {
+		return 1
+	}
+	return 0
+}
+
+// runInternal mirrors GenerateCommand.runInternal, but stops short of
+// marshalling Framework IR - every error that would normally abort generation
+// is instead collected as a diagnostic so the caller sees every problem in
+// the config/OAS pairing in one pass.
+func (cmd *ValidateCommand) runInternal() (diag.Diagnostics, error) {
+	var diagnostics diag.Diagnostics
+
+	// 1. Read and parse generator config file
+	configBytes, err := os.ReadFile(cmd.flagConfigPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read generator config file: %w", err)
+	}
+	cfg, err := config.ParseConfig(configBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse generator config file: %w", err)
+	}
+
+	// 2. Read and parse OpenAPI spec file
+	oasBytes, err := os.ReadFile(cmd.oasInputPath)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read OpenAPI spec file: %w", err)
+	}
+	doc, err := libopenapi.NewDocument(oasBytes)
+	if err != nil {
+		return nil, fmt.Errorf("failed to parse OpenAPI spec file: %w", err)
+	}
+
+	// 3. Build out the OpenAPI model - circular refs and other model issues
+	// are reported as warning diagnostics rather than failing the command.
+	model, errs := doc.BuildV3Model()
+	for _, modelErr := range errs {
+		diagnostics.Append(diag.SeverityWarning, "potential issue building OpenAPI model", modelErr.Error())
+	}
+
+	// 4. Lint the OAS for constructs the mapper doesn't support, and the
+	// config's function invocations against the resolved OAS paths. These
+	// run independently of the explorer/mapper pipeline below, so a single
+	// broken resource doesn't hide every other finding.
+	diagnostics = append(diagnostics, lintSchemas(model.Model)...)
+	diagnostics = append(diagnostics, lintFunctions(model.Model, *cfg)...)
+	diagnostics = append(diagnostics, lintResourceSchemas(model.Model)...)
+
+	// 5. Run the explorer/mapper pipeline in dry-run mode, collecting every
+	// error as a diagnostic instead of returning on the first one.
+	oasExplorer := explorer.NewConfigExplorer(model.Model, *cfg)
+
+	explorerResources, err := oasExplorer.FindResources()
+	if err != nil {
+		diagnostics.AppendWithLocation(diag.SeverityError, "failed to resolve resources from config", err.Error(), "", "resources")
+	} else if _, err := mapper.NewResourceMapper(explorerResources, *cfg).MapToIR(); err != nil {
+		diagnostics.AppendWithLocation(diag.SeverityError, "failed to map resources to Framework IR", err.Error(), "", "resources")
+	}
+
+	explorerDataSources, err := oasExplorer.FindDataSources()
+	if err != nil {
+		diagnostics.AppendWithLocation(diag.SeverityError, "failed to resolve data sources from config", err.Error(), "", "data_sources")
+	} else if _, err := mapper.NewDataSourceMapper(explorerDataSources, *cfg).MapToIR(); err != nil {
+		diagnostics.AppendWithLocation(diag.SeverityError, "failed to map data sources to Framework IR", err.Error(), "", "data_sources")
+	}
+
+	explorerProvider, err := oasExplorer.FindProvider()
+	if err != nil {
+		diagnostics.AppendWithLocation(diag.SeverityError, "failed to resolve provider from config", err.Error(), "", "provider")
+	} else if _, err := mapper.NewProviderMapper(explorerProvider, *cfg).MapToIR(); err != nil {
+		diagnostics.AppendWithLocation(diag.SeverityError, "failed to map provider to Framework IR", err.Error(), "", "provider")
+	}
+
+	return diagnostics, nil
+}
+
+func (cmd *ValidateCommand) output(diagnostics diag.Diagnostics) error {
+	if len(diagnostics) == 0 {
+		cmd.UI.Output("No issues found.")
+		return nil
+	}
+
+	if cmd.flagJSON {
+		bytes, err := json.MarshalIndent(diagnostics, "", "\t")
+		if err != nil {
+			return fmt.Errorf("error marshalling diagnostics to JSON: %w", err)
+		}
+		cmd.UI.Output(string(bytes))
+		return nil
+	}
+
+	for _, diagnostic := range diagnostics {
+		if diagnostic.Severity == diag.SeverityError {
+			cmd.UI.Error(diagnostic.String())
+			continue
+		}
+		cmd.UI.Warn(diagnostic.String())
+	}
+
+	return nil
+}
@@ -0,0 +1,239 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/diag"
+)
+
+// lintSchemas reports the OAS constructs the mapper doesn't support (oneOf,
+// anyOf, discriminators), wherever they appear: every named component
+// schema, plus every inline request/response body schema declared directly
+// on a path operation, since those are never `$ref`s into
+// model.Components.Schemas and would otherwise be invisible to this lint.
+func lintSchemas(model v3high.Document) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if model.Components != nil && model.Components.Schemas != nil {
+		for name, schemaProxy := range model.Components.Schemas.FromOldest() {
+			pointer := fmt.Sprintf("#/components/schemas/%s", jsonPointerEscape(name))
+			diagnostics = append(diagnostics, lintSchemaConstructs(schemaProxy, pointer)...)
+		}
+	}
+
+	if model.Paths == nil || model.Paths.PathItems == nil {
+		return diagnostics
+	}
+
+	for path, pathItem := range model.Paths.PathItems.FromOldest() {
+		pathPointer := fmt.Sprintf("#/paths/%s", jsonPointerEscape(path))
+
+		for method, operation := range operationsByMethod(pathItem) {
+			if operation == nil {
+				continue
+			}
+			operationPointer := fmt.Sprintf("%s/%s", pathPointer, method)
+
+			if operation.RequestBody != nil {
+				for mediaType, content := range operation.RequestBody.Content.FromOldest() {
+					if content == nil || content.Schema == nil {
+						continue
+					}
+					pointer := fmt.Sprintf("%s/requestBody/content/%s/schema", operationPointer, jsonPointerEscape(mediaType))
+					diagnostics = append(diagnostics, lintSchemaConstructs(content.Schema, pointer)...)
+				}
+			}
+
+			if operation.Responses == nil || operation.Responses.Codes == nil {
+				continue
+			}
+			for code, response := range operation.Responses.Codes.FromOldest() {
+				if response == nil || response.Content == nil {
+					continue
+				}
+				for mediaType, content := range response.Content.FromOldest() {
+					if content == nil || content.Schema == nil {
+						continue
+					}
+					pointer := fmt.Sprintf("%s/responses/%s/content/%s/schema", operationPointer, jsonPointerEscape(code), jsonPointerEscape(mediaType))
+					diagnostics = append(diagnostics, lintSchemaConstructs(content.Schema, pointer)...)
+				}
+			}
+		}
+	}
+
+	return diagnostics
+}
+
+// lintSchemaConstructs reports the OAS constructs the mapper doesn't support
+// on a single resolved schema, pointing at it via pointer.
+func lintSchemaConstructs(schemaProxy *base.SchemaProxy, pointer string) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	schema, err := schemaProxy.BuildSchema()
+	if err != nil {
+		diagnostics.AppendWithLocation(diag.SeverityWarning, "failed to resolve schema", err.Error(), pointer, "")
+		return diagnostics
+	}
+
+	if len(schema.OneOf) > 0 {
+		diagnostics.AppendWithLocation(diag.SeverityWarning, "unsupported OAS construct: oneOf", "oneOf doesn't map onto a single Framework IR attribute type and will need a manual override", pointer, "")
+	}
+	if len(schema.AnyOf) > 0 {
+		diagnostics.AppendWithLocation(diag.SeverityWarning, "unsupported OAS construct: anyOf", "anyOf doesn't map onto a single Framework IR attribute type and will need a manual override", pointer, "")
+	}
+	if schema.Discriminator != nil {
+		diagnostics.AppendWithLocation(diag.SeverityWarning, "unsupported OAS construct: discriminator", "discriminated schemas are flattened rather than mapped to a polymorphic Framework IR type", pointer, "")
+	}
+
+	return diagnostics
+}
+
+// lintResourceSchemas reports, for every path that looks like it maps to a
+// single resource (it has both a "read" GET and a "create" POST or PUT
+// operation), a warning when their success response schemas disagree on
+// their set of top-level properties. The mapper resolves a resource's
+// schema from exactly one of these operations, so a mismatch here means
+// whichever one it didn't pick will drift from the generated schema.
+func lintResourceSchemas(model v3high.Document) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	if model.Paths == nil || model.Paths.PathItems == nil {
+		return diagnostics
+	}
+
+	for path, pathItem := range model.Paths.PathItems.FromOldest() {
+		readProps, readOk := successSchemaProperties(pathItem.Get)
+		createOperation := pathItem.Post
+		if createOperation == nil {
+			createOperation = pathItem.Put
+		}
+		createProps, createOk := successSchemaProperties(createOperation)
+
+		if !readOk || !createOk {
+			continue
+		}
+		if propertiesEqual(readProps, createProps) {
+			continue
+		}
+
+		pointer := fmt.Sprintf("#/paths/%s", jsonPointerEscape(path))
+		detail := fmt.Sprintf("the read operation's response properties (%s) and the create operation's response properties (%s) don't match; the mapper will only use one of them as this resource's schema", strings.Join(readProps, ", "), strings.Join(createProps, ", "))
+		diagnostics.AppendWithLocation(diag.SeverityWarning, "ambiguous read vs. create schema", detail, pointer, "")
+	}
+
+	return diagnostics
+}
+
+// successSchemaProperties returns the sorted top-level property names of
+// operation's first 2xx JSON response schema, and false if operation is nil
+// or has none.
+func successSchemaProperties(operation *v3high.Operation) ([]string, bool) {
+	if operation == nil || operation.Responses == nil || operation.Responses.Codes == nil {
+		return nil, false
+	}
+
+	for code, response := range operation.Responses.Codes.FromOldest() {
+		if !strings.HasPrefix(code, "2") || response == nil || response.Content == nil {
+			continue
+		}
+		mediaType := response.Content.GetOrZero("application/json")
+		if mediaType == nil || mediaType.Schema == nil {
+			continue
+		}
+		schema, err := mediaType.Schema.BuildSchema()
+		if err != nil || schema.Properties == nil {
+			continue
+		}
+
+		var names []string
+		for name := range schema.Properties.FromOldest() {
+			names = append(names, name)
+		}
+		sort.Strings(names)
+		return names, true
+	}
+
+	return nil, false
+}
+
+func propertiesEqual(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// operationsByMethod returns pathItem's operations keyed by lowercase HTTP
+// method, omitting methods it doesn't define.
+func operationsByMethod(pathItem *v3high.PathItem) map[string]*v3high.Operation {
+	return map[string]*v3high.Operation{
+		"get":     pathItem.Get,
+		"put":     pathItem.Put,
+		"post":    pathItem.Post,
+		"delete":  pathItem.Delete,
+		"options": pathItem.Options,
+		"head":    pathItem.Head,
+		"patch":   pathItem.Patch,
+		"trace":   pathItem.Trace,
+	}
+}
+
+// lintFunctions checks every configured function's Invoke location against
+// the resolved OAS paths, reporting path/method combinations that don't
+// exist.
+func lintFunctions(model v3high.Document, cfg config.Config) diag.Diagnostics {
+	var diagnostics diag.Diagnostics
+
+	for name, fn := range cfg.Functions {
+		configPath := fmt.Sprintf("functions.%s.invoke", name)
+
+		if model.Paths == nil || model.Paths.PathItems == nil {
+			diagnostics.AppendWithLocation(diag.SeverityError, "path does not exist in OpenAPI spec", fn.Invoke.Path, "#/paths", configPath)
+			continue
+		}
+
+		pathItem := model.Paths.PathItems.GetOrZero(fn.Invoke.Path)
+		if pathItem == nil {
+			diagnostics.AppendWithLocation(diag.SeverityError, "path does not exist in OpenAPI spec", fn.Invoke.Path, fmt.Sprintf("#/paths/%s", jsonPointerEscape(fn.Invoke.Path)), configPath)
+			continue
+		}
+
+		if operationForMethod(pathItem, fn.Invoke.Method) == nil {
+			pointer := fmt.Sprintf("#/paths/%s/%s", jsonPointerEscape(fn.Invoke.Path), strings.ToLower(fn.Invoke.Method))
+			diagnostics.AppendWithLocation(diag.SeverityError, "method does not exist for path in OpenAPI spec", fn.Invoke.Method, pointer, configPath)
+		}
+	}
+
+	return diagnostics
+}
+
+// operationForMethod returns the Operation on pathItem matching method
+// (case-insensitive), or nil if pathItem has none.
+func operationForMethod(pathItem *v3high.PathItem, method string) *v3high.Operation {
+	return operationsByMethod(pathItem)[strings.ToLower(method)]
+}
+
+// jsonPointerEscape escapes a single JSON pointer reference token per
+// RFC 6901 (a literal "~" or "/" inside the token, e.g. a path like
+// "/widgets/{id}" used whole as a map key, would otherwise be parsed as
+// pointer path separators).
+func jsonPointerEscape(token string) string {
+	token = strings.ReplaceAll(token, "~", "~0")
+	token = strings.ReplaceAll(token, "/", "~1")
+	return token
+}
@@ -0,0 +1,40 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package cmd
+
+import "testing"
+
+func TestJSONPointerEscape(t *testing.T) {
+	tests := map[string]string{
+		"/widgets/{id}": "~1widgets~1{id}",
+		"plain":         "plain",
+		"a~b":           "a~0b",
+	}
+
+	for input, want := range tests {
+		if got := jsonPointerEscape(input); got != want {
+			t.Errorf("jsonPointerEscape(%q) = %q, want %q", input, got, want)
+		}
+	}
+}
+
+func TestPropertiesEqual(t *testing.T) {
+	t.Run("equal", func(t *testing.T) {
+		if !propertiesEqual([]string{"id", "name"}, []string{"id", "name"}) {
+			t.Error("expected identical slices to be equal")
+		}
+	})
+
+	t.Run("different length", func(t *testing.T) {
+		if propertiesEqual([]string{"id"}, []string{"id", "name"}) {
+			t.Error("expected slices of different length to be unequal")
+		}
+	})
+
+	t.Run("different contents", func(t *testing.T) {
+		if propertiesEqual([]string{"id", "name"}, []string{"id", "description"}) {
+			t.Error("expected slices with different contents to be unequal")
+		}
+	})
+}
@@ -0,0 +1,222 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+)
+
+// ApplyResourceOverrides applies every registered ResourceConfig to its
+// matching resource in resources, mutating each resource's schema in place.
+// It's a no-op for resources without a registered override.
+func ApplyResourceOverrides(resources []resource.Resource, registry ResourceConfigRegistry) error {
+	for i := range resources {
+		cfg, ok := registry[resources[i].Name]
+		if !ok {
+			continue
+		}
+
+		if err := applyResourceConfig(&resources[i], cfg); err != nil {
+			return fmt.Errorf("error applying resource config overrides to '%s': %w", resources[i].Name, err)
+		}
+	}
+
+	return nil
+}
+
+// applyResourceConfig mutates res.Schema.Attributes by round-tripping it
+// through a generic JSON representation: this lets us locate and edit an
+// attribute by dotted path without having to duplicate the full Framework IR
+// attribute union here, while still decoding the mutated result back through
+// the real resource.Attributes type so nothing it natively supports is lost.
+func applyResourceConfig(res *resource.Resource, cfg ResourceConfig) error {
+	if res.Schema == nil {
+		return nil
+	}
+
+	attrsJSON, err := json.Marshal(res.Schema.Attributes)
+	if err != nil {
+		return fmt.Errorf("error marshalling attributes: %w", err)
+	}
+
+	var attrs []map[string]interface{}
+	if err := json.Unmarshal(attrsJSON, &attrs); err != nil {
+		return fmt.Errorf("error decoding attributes: %w", err)
+	}
+
+	for _, excluded := range cfg.LateInitialization.Exclude {
+		if detail, ok := typeDetail(locate(attrs, splitAttributePath(excluded))); ok {
+			appendPlanModifier(detail, cfg.PlanModifiersImportPath, "planmodifiers.LateInitExclude()")
+		}
+	}
+
+	for _, sensitivePath := range cfg.Sensitive {
+		if detail, ok := typeDetail(locate(attrs, splitAttributePath(sensitivePath))); ok {
+			detail["sensitive"] = true
+		}
+	}
+
+	for path, targetType := range cfg.TypeOverrides {
+		overrideType(attrs, splitAttributePath(path), targetType)
+	}
+
+	for path, def := range cfg.Defaults {
+		if detail, ok := typeDetail(locate(attrs, splitAttributePath(path))); ok {
+			applyDefault(detail, cfg.PlanModifiersImportPath, def)
+		}
+	}
+
+	for path, ref := range cfg.References {
+		if detail, ok := typeDetail(locate(attrs, splitAttributePath(path))); ok {
+			appendPlanModifier(detail, cfg.PlanModifiersImportPath, fmt.Sprintf("planmodifiers.References(%q, %q)", ref.Resource, ref.Attribute))
+		}
+	}
+
+	mutatedJSON, err := json.Marshal(attrs)
+	if err != nil {
+		return fmt.Errorf("error encoding attributes: %w", err)
+	}
+
+	if err := json.Unmarshal(mutatedJSON, &res.Schema.Attributes); err != nil {
+		return fmt.Errorf("error applying overrides to attributes: %w", err)
+	}
+
+	return nil
+}
+
+func splitAttributePath(path string) []string {
+	return strings.Split(path, ".")
+}
+
+// locate walks attrs by dotted path, descending into nested object/list/set
+// attributes' nested_object.attributes as needed, and returns the raw
+// attribute map at that path.
+func locate(attrs []map[string]interface{}, path []string) (map[string]interface{}, bool) {
+	if len(path) == 0 {
+		return nil, false
+	}
+
+	for _, attr := range attrs {
+		if attr["name"] != path[0] {
+			continue
+		}
+		if len(path) == 1 {
+			return attr, true
+		}
+
+		nested, ok := nestedAttributes(attr)
+		if !ok {
+			return nil, false
+		}
+		return locate(nested, path[1:])
+	}
+
+	return nil, false
+}
+
+// typeDetail finds the single populated type-detail map within an attribute
+// (e.g. the value under "string" or "list_nested") - the one holding
+// computed_optional_required - regardless of which type it is.
+func typeDetail(attr map[string]interface{}, found bool) (map[string]interface{}, bool) {
+	if !found {
+		return nil, false
+	}
+	for key, value := range attr {
+		if key == "name" {
+			continue
+		}
+		detail, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := detail["computed_optional_required"]; ok {
+			return detail, true
+		}
+	}
+	return nil, false
+}
+
+func nestedAttributes(attr map[string]interface{}) ([]map[string]interface{}, bool) {
+	detail, ok := typeDetail(attr, true)
+	if !ok {
+		return nil, false
+	}
+	nestedObject, ok := detail["nested_object"].(map[string]interface{})
+	if !ok {
+		return nil, false
+	}
+	rawAttrs, ok := nestedObject["attributes"].([]interface{})
+	if !ok {
+		return nil, false
+	}
+
+	nested := make([]map[string]interface{}, 0, len(rawAttrs))
+	for _, rawAttr := range rawAttrs {
+		if attrMap, ok := rawAttr.(map[string]interface{}); ok {
+			nested = append(nested, attrMap)
+		}
+	}
+	return nested, true
+}
+
+// overrideType swaps an attribute's populated type key (e.g. "string") for
+// targetType, carrying over the common fields (computed_optional_required,
+// description, ...) the old type detail already had.
+func overrideType(attrs []map[string]interface{}, path []string, targetType string) {
+	attr, ok := locate(attrs, path)
+	if !ok {
+		return
+	}
+
+	for key, value := range attr {
+		if key == "name" {
+			continue
+		}
+		detail, ok := value.(map[string]interface{})
+		if !ok {
+			continue
+		}
+		if _, ok := detail["computed_optional_required"]; !ok {
+			continue
+		}
+		delete(attr, key)
+		attr[targetType] = detail
+		return
+	}
+}
+
+// appendPlanModifier records a custom plan modifier on a type detail, using
+// the Framework IR's "custom" escape hatch. schemaDefinition must be a
+// literal Go expression (e.g. "planmodifiers.LateInitExclude()") -
+// framework-codegen splices it verbatim into the generated
+// "PlanModifiers: []planmodifier.String{ ... }" slice, so anything else
+// produces generated code that fails to compile. importPath must name a
+// package the *target* provider actually owns or depends on - this tool's
+// own internal packages are never importable from a generated provider's
+// module - and comes from the resource's ResourceConfig.PlanModifiersImportPath.
+func appendPlanModifier(detail map[string]interface{}, importPath, schemaDefinition string) {
+	existing, _ := detail["plan_modifiers"].([]interface{})
+	detail["plan_modifiers"] = append(existing, map[string]interface{}{
+		"custom": map[string]interface{}{
+			"imports": []interface{}{
+				map[string]interface{}{"path": importPath},
+			},
+			"schema_definition": schemaDefinition,
+		},
+	})
+}
+
+func applyDefault(detail map[string]interface{}, importPath string, def DefaultAnnotation) {
+	if def.ServerSide {
+		appendPlanModifier(detail, importPath, fmt.Sprintf("planmodifiers.ServerSideDefault(%#v)", def.Value))
+		return
+	}
+	detail["default"] = map[string]interface{}{
+		"static": def.Value,
+	}
+}
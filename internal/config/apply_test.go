@@ -0,0 +1,128 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import "testing"
+
+func TestLocate(t *testing.T) {
+	attrs := []map[string]interface{}{
+		{
+			"name": "id",
+			"string": map[string]interface{}{
+				"computed_optional_required": "computed",
+			},
+		},
+		{
+			"name": "parent",
+			"single_nested": map[string]interface{}{
+				"computed_optional_required": "optional",
+				"nested_object": map[string]interface{}{
+					"attributes": []interface{}{
+						map[string]interface{}{
+							"name": "parent_id",
+							"string": map[string]interface{}{
+								"computed_optional_required": "computed_optional",
+							},
+						},
+					},
+				},
+			},
+		},
+	}
+
+	t.Run("top-level attribute", func(t *testing.T) {
+		attr, ok := locate(attrs, splitAttributePath("id"))
+		if !ok {
+			t.Fatal("expected to locate 'id'")
+		}
+		if attr["name"] != "id" {
+			t.Fatalf("unexpected attribute: %v", attr)
+		}
+	})
+
+	t.Run("nested attribute", func(t *testing.T) {
+		attr, ok := locate(attrs, splitAttributePath("parent.parent_id"))
+		if !ok {
+			t.Fatal("expected to locate 'parent.parent_id'")
+		}
+		if attr["name"] != "parent_id" {
+			t.Fatalf("unexpected attribute: %v", attr)
+		}
+	})
+
+	t.Run("missing attribute", func(t *testing.T) {
+		if _, ok := locate(attrs, splitAttributePath("missing")); ok {
+			t.Fatal("expected 'missing' to not be located")
+		}
+	})
+
+	t.Run("missing nested attribute", func(t *testing.T) {
+		if _, ok := locate(attrs, splitAttributePath("parent.missing")); ok {
+			t.Fatal("expected 'parent.missing' to not be located")
+		}
+	})
+}
+
+func TestOverrideType(t *testing.T) {
+	attrs := []map[string]interface{}{
+		{
+			"name": "count",
+			"string": map[string]interface{}{
+				"computed_optional_required": "optional",
+				"description":                "a count, mistakenly typed as a string in the OAS",
+			},
+		},
+	}
+
+	overrideType(attrs, splitAttributePath("count"), "int64")
+
+	attr, ok := locate(attrs, splitAttributePath("count"))
+	if !ok {
+		t.Fatal("expected to locate 'count'")
+	}
+
+	if _, ok := attr["string"]; ok {
+		t.Fatal("expected 'string' type detail to be replaced")
+	}
+
+	detail, ok := attr["int64"].(map[string]interface{})
+	if !ok {
+		t.Fatal("expected 'int64' type detail to be present")
+	}
+	if detail["computed_optional_required"] != "optional" {
+		t.Fatalf("expected common fields to carry over, got: %v", detail)
+	}
+}
+
+func TestAppendPlanModifier(t *testing.T) {
+	detail := map[string]interface{}{
+		"computed_optional_required": "computed_optional",
+	}
+
+	const importPath = "github.com/example/terraform-provider-example/internal/planmodifiers"
+
+	appendPlanModifier(detail, importPath, "planmodifiers.LateInitExclude()")
+
+	modifiers, ok := detail["plan_modifiers"].([]interface{})
+	if !ok || len(modifiers) != 1 {
+		t.Fatalf("expected one plan modifier, got: %v", detail["plan_modifiers"])
+	}
+
+	custom, ok := modifiers[0].(map[string]interface{})["custom"].(map[string]interface{})
+	if !ok {
+		t.Fatal("expected a 'custom' plan modifier")
+	}
+
+	if custom["schema_definition"] != "planmodifiers.LateInitExclude()" {
+		t.Fatalf("expected a literal Go expression, got: %v", custom["schema_definition"])
+	}
+
+	imports, ok := custom["imports"].([]interface{})
+	if !ok || len(imports) != 1 {
+		t.Fatalf("expected a matching import, got: %v", custom["imports"])
+	}
+	if imports[0].(map[string]interface{})["path"] != importPath {
+		t.Fatalf("expected the caller-supplied import path %q, got: %v", importPath, imports[0])
+	}
+}
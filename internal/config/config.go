@@ -0,0 +1,39 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"fmt"
+
+	"gopkg.in/yaml.v3"
+)
+
+// Config is the parsed generator config file (tfopenapigen_config.yml).
+type Config struct {
+	// Functions declares OAS operations that should be mapped to Terraform
+	// provider-defined functions instead of a resource or data source.
+	Functions map[string]Function `yaml:"functions,omitempty"`
+
+	// ResourceOverrides declares YAML-based ResourceConfig overrides, merged
+	// with any programmatically registered ones by Config.ResourceConfigs.
+	ResourceOverrides ResourceConfigRegistry `yaml:"resources,omitempty"`
+}
+
+// ParseConfig parses a generator config file from its raw YAML bytes.
+func ParseConfig(configBytes []byte) (*Config, error) {
+	var cfg Config
+	if err := yaml.Unmarshal(configBytes, &cfg); err != nil {
+		return nil, fmt.Errorf("error parsing generator config: %w", err)
+	}
+
+	return &cfg, nil
+}
+
+// OpenApiSpecLocation identifies a single OAS operation by path and method,
+// used to point a config entry (e.g. a Function's Invoke) at the operation
+// it maps.
+type OpenApiSpecLocation struct {
+	Path   string `yaml:"path"`
+	Method string `yaml:"method"`
+}
@@ -0,0 +1,24 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+// Function declares that a single OAS operation should be mapped to a
+// Terraform 1.8+ provider-defined function rather than a resource or data
+// source. Unlike Resource/DataSource, a Function only ever wraps one
+// operation, since functions have no CRUD lifecycle.
+type Function struct {
+	// Invoke is the OAS path/method that implements the function.
+	Invoke OpenApiSpecLocation `yaml:"invoke"`
+
+	SchemaOptions FunctionSchemaOptions `yaml:"schema,omitempty"`
+}
+
+// FunctionSchemaOptions holds per-function overrides applied when mapping
+// the invoking operation's parameters/response to a function signature.
+type FunctionSchemaOptions struct {
+	// IgnoredParameters excludes request parameters (path, query, or header)
+	// from the generated function signature, e.g. for values the provider
+	// fills in itself.
+	IgnoredParameters []string `yaml:"ignored_parameters,omitempty"`
+}
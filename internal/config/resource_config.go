@@ -0,0 +1,97 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import "sync"
+
+// ResourceConfig declares post-mapping overrides for a single generated
+// resource, analogous to terrajet's config.Resource: late-initialization
+// exclusions, extra sensitive-field marking, type overrides, default
+// annotations, and cross-resource attribute References. Overrides are
+// applied by ApplyResourceOverrides after the OAS-to-IR mapping runs, so
+// they compose with (rather than replace) the normal mapping pipeline.
+type ResourceConfig struct {
+	LateInitialization LateInitializationConfig     `yaml:"late_initialization,omitempty"`
+	Sensitive          []string                     `yaml:"sensitive,omitempty"`
+	TypeOverrides      map[string]string            `yaml:"type_overrides,omitempty"`
+	Defaults           map[string]DefaultAnnotation `yaml:"defaults,omitempty"`
+	References         map[string]ResourceReference `yaml:"references,omitempty"`
+
+	// PlanModifiersImportPath is the Go import path of the target provider's
+	// own package implementing LateInitExclude, References, and
+	// ServerSideDefault - the custom plan modifiers emitted for
+	// LateInitialization.Exclude, References, and server-side Defaults.
+	// framework-codegen splices this tool's own internal packages into
+	// nothing; it has no visibility into a generated provider's module, so
+	// this must name a package the provider itself vendors or depends on.
+	PlanModifiersImportPath string `yaml:"plan_modifiers_import_path,omitempty"`
+}
+
+// LateInitializationConfig lists attributes that should NOT be
+// late-initialized from the server's response - i.e. the provider should
+// always defer to the practitioner's config value for them.
+type LateInitializationConfig struct {
+	Exclude []string `yaml:"exclude,omitempty"`
+}
+
+// DefaultAnnotation describes a default value for an attribute beyond what
+// the OAS `default` keyword already produces.
+type DefaultAnnotation struct {
+	Value      any  `yaml:"value"`
+	ServerSide bool `yaml:"server_side,omitempty"`
+}
+
+// ResourceReference declares that an attribute's value refers to another
+// generated resource's attribute, analogous to terrajet's config.Reference.
+type ResourceReference struct {
+	Resource  string `yaml:"resource"`
+	Attribute string `yaml:"attribute"`
+}
+
+// ResourceConfigRegistry maps resource name to its ResourceConfig overrides.
+type ResourceConfigRegistry map[string]ResourceConfig
+
+// registeredResourceConfigs holds overrides registered programmatically via
+// RegisterResourceConfig, for providers complex enough to want to drive
+// generation from Go rather than (or in addition to) YAML.
+//
+// This map is process-global and never cleared: entries persist for the life
+// of the process and there's no unregister. That's fine for the one-shot
+// `tfplugingen-openapi generate`/`validate` CLI invocations this package is
+// built for, but it's the wrong shape to drive this package as a library
+// across multiple OAS inputs/providers (or from parallel tests) in one
+// process - registrations from one run leak into the next, and a resource
+// name collision between unrelated providers silently overwrites the first
+// registration. registeredResourceConfigsMu only protects the map itself
+// from concurrent access; it doesn't address that leakage.
+var (
+	registeredResourceConfigsMu sync.RWMutex
+	registeredResourceConfigs   = ResourceConfigRegistry{}
+)
+
+// RegisterResourceConfig adds a programmatic ResourceConfig override for the
+// named resource. Intended to be called (e.g. from an init()) before the
+// generate/validate commands run.
+func RegisterResourceConfig(resourceName string, cfg ResourceConfig) {
+	registeredResourceConfigsMu.Lock()
+	defer registeredResourceConfigsMu.Unlock()
+	registeredResourceConfigs[resourceName] = cfg
+}
+
+// ResourceConfigs merges YAML-declared overrides (ResourceOverrides) with
+// any programmatically registered ones. YAML entries take precedence since
+// they're visible at the generation call site.
+func (c Config) ResourceConfigs() ResourceConfigRegistry {
+	registeredResourceConfigsMu.RLock()
+	defer registeredResourceConfigsMu.RUnlock()
+
+	merged := ResourceConfigRegistry{}
+	for name, cfg := range registeredResourceConfigs {
+		merged[name] = cfg
+	}
+	for name, cfg := range c.ResourceOverrides {
+		merged[name] = cfg
+	}
+	return merged
+}
@@ -0,0 +1,56 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package config
+
+import (
+	"sync"
+	"testing"
+)
+
+func TestResourceConfigsMergesYAMLOverProgrammatic(t *testing.T) {
+	RegisterResourceConfig("widget", ResourceConfig{Sensitive: []string{"secret"}})
+	defer func() {
+		registeredResourceConfigsMu.Lock()
+		delete(registeredResourceConfigs, "widget")
+		registeredResourceConfigsMu.Unlock()
+	}()
+
+	cfg := Config{
+		ResourceOverrides: ResourceConfigRegistry{
+			"widget": {Sensitive: []string{"override"}},
+			"gadget": {Sensitive: []string{"token"}},
+		},
+	}
+
+	merged := cfg.ResourceConfigs()
+
+	if got := merged["widget"].Sensitive; len(got) != 1 || got[0] != "override" {
+		t.Fatalf("expected the YAML override to win for 'widget', got: %v", got)
+	}
+	if got := merged["gadget"].Sensitive; len(got) != 1 || got[0] != "token" {
+		t.Fatalf("expected 'gadget' to carry its YAML-only override, got: %v", got)
+	}
+}
+
+func TestRegisterResourceConfigConcurrentAccess(t *testing.T) {
+	var wg sync.WaitGroup
+
+	for i := 0; i < 50; i++ {
+		wg.Add(2)
+		go func(i int) {
+			defer wg.Done()
+			RegisterResourceConfig("concurrent", ResourceConfig{Sensitive: []string{"x"}})
+		}(i)
+		go func() {
+			defer wg.Done()
+			_ = (Config{}).ResourceConfigs()
+		}()
+	}
+
+	wg.Wait()
+
+	registeredResourceConfigsMu.Lock()
+	delete(registeredResourceConfigs, "concurrent")
+	registeredResourceConfigsMu.Unlock()
+}
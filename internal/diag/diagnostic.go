@@ -0,0 +1,78 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package diag provides a small diagnostics collection type shared by commands
+// that need to report multiple non-fatal issues (for example `validate`)
+// instead of failing on the first error encountered.
+package diag
+
+import "fmt"
+
+// Severity indicates how serious a Diagnostic is. Warnings are informational
+// and don't affect a command's exit code; errors do.
+type Severity string
+
+const (
+	SeverityError   Severity = "error"
+	SeverityWarning Severity = "warning"
+)
+
+// Diagnostic is a single actionable finding, optionally pointing at the
+// location in the OpenAPI spec (via JSON pointer) and/or the generator
+// config that caused it.
+type Diagnostic struct {
+	Severity    Severity `json:"severity"`
+	Summary     string   `json:"summary"`
+	Detail      string   `json:"detail,omitempty"`
+	JSONPointer string   `json:"json_pointer,omitempty"`
+	ConfigPath  string   `json:"config_path,omitempty"`
+}
+
+func (d Diagnostic) String() string {
+	prefix := fmt.Sprintf("[%s]", d.Severity)
+	switch {
+	case d.JSONPointer != "" && d.ConfigPath != "":
+		return fmt.Sprintf("%s %s (oas: %s, config: %s): %s", prefix, d.Summary, d.JSONPointer, d.ConfigPath, d.Detail)
+	case d.JSONPointer != "":
+		return fmt.Sprintf("%s %s (oas: %s): %s", prefix, d.Summary, d.JSONPointer, d.Detail)
+	case d.ConfigPath != "":
+		return fmt.Sprintf("%s %s (config: %s): %s", prefix, d.Summary, d.ConfigPath, d.Detail)
+	default:
+		return fmt.Sprintf("%s %s: %s", prefix, d.Summary, d.Detail)
+	}
+}
+
+// Diagnostics is an ordered collection of Diagnostic entries.
+type Diagnostics []Diagnostic
+
+// Append adds a new Diagnostic to the collection.
+func (d *Diagnostics) Append(severity Severity, summary, detail string) {
+	*d = append(*d, Diagnostic{
+		Severity: severity,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+// AppendWithLocation adds a new Diagnostic that points at a location in the
+// OpenAPI spec and/or the generator config.
+func (d *Diagnostics) AppendWithLocation(severity Severity, summary, detail, jsonPointer, configPath string) {
+	*d = append(*d, Diagnostic{
+		Severity:    severity,
+		Summary:     summary,
+		Detail:      detail,
+		JSONPointer: jsonPointer,
+		ConfigPath:  configPath,
+	})
+}
+
+// HasErrors reports whether any Diagnostic in the collection has
+// SeverityError.
+func (d Diagnostics) HasErrors() bool {
+	for _, diagnostic := range d {
+		if diagnostic.Severity == SeverityError {
+			return true
+		}
+	}
+	return false
+}
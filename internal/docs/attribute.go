@@ -0,0 +1,148 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import "encoding/json"
+
+// AttributeRow is a flattened, render-friendly view of a single Framework IR
+// attribute (or block), built by walking the marshalled JSON of a
+// spec.ResourceSchema/spec.DataSourceSchema rather than the Go types
+// directly, so the same logic works for resources, data sources, and any
+// nested attributes they contain.
+type AttributeRow struct {
+	Name        string
+	Type        string
+	Mode        string // "required", "optional", "computed", or "computed_optional"
+	Description string
+	Nested      []AttributeRow
+
+	// Default is the attribute's literal default value, as a Go source/HCL
+	// literal (e.g. `"active"`, `42`, `true`), when the Framework IR carries
+	// one - empty otherwise. JSON and HCL agree on literal syntax for
+	// strings, numbers, and booleans, so the raw JSON value round-trips
+	// as-is.
+	Default string
+}
+
+// rawAttribute mirrors the subset of the Framework IR JSON attribute shape
+// that docs rendering cares about: a name, exactly one populated type key,
+// and that type's common fields.
+type rawAttribute struct {
+	Name string `json:"name"`
+
+	Bool    *rawTypeDetail `json:"bool,omitempty"`
+	String  *rawTypeDetail `json:"string,omitempty"`
+	Int64   *rawTypeDetail `json:"int64,omitempty"`
+	Float64 *rawTypeDetail `json:"float64,omitempty"`
+	Number  *rawTypeDetail `json:"number,omitempty"`
+
+	List *rawNestedDetail `json:"list,omitempty"`
+	Map  *rawNestedDetail `json:"map,omitempty"`
+	Set  *rawNestedDetail `json:"set,omitempty"`
+
+	SingleNested *rawNestedObjectDetail `json:"single_nested,omitempty"`
+	ListNested   *rawNestedObjectDetail `json:"list_nested,omitempty"`
+	SetNested    *rawNestedObjectDetail `json:"set_nested,omitempty"`
+}
+
+type rawTypeDetail struct {
+	ComputedOptionalRequired string      `json:"computed_optional_required"`
+	Description              *string     `json:"description,omitempty"`
+	Default                  *rawDefault `json:"default,omitempty"`
+}
+
+type rawNestedDetail struct {
+	ComputedOptionalRequired string      `json:"computed_optional_required"`
+	Description              *string     `json:"description,omitempty"`
+	Default                  *rawDefault `json:"default,omitempty"`
+}
+
+type rawNestedObjectDetail struct {
+	ComputedOptionalRequired string       `json:"computed_optional_required"`
+	Description              *string      `json:"description,omitempty"`
+	NestedObject             rawNestedObj `json:"nested_object"`
+}
+
+// rawDefault mirrors the Framework IR's static-default escape hatch (see
+// config.applyDefault's "default.static"): Static holds the default's raw
+// JSON literal, which happens to also be valid HCL literal syntax for the
+// scalar/collection types a default can hold.
+type rawDefault struct {
+	Static json.RawMessage `json:"static,omitempty"`
+}
+
+type rawNestedObj struct {
+	Attributes []rawAttribute `json:"attributes"`
+}
+
+// ExtractAttributes walks the JSON-marshalled form of a Framework IR
+// attribute slice (spec.ResourceSchema.Attributes / DataSourceSchema.Attributes,
+// both of which marshal to a plain JSON array) and returns a render-friendly
+// AttributeRow per attribute, recursing into nested object/list/set
+// attributes.
+func ExtractAttributes(attributesJSON []byte) ([]AttributeRow, error) {
+	var raw []rawAttribute
+	if err := json.Unmarshal(attributesJSON, &raw); err != nil {
+		return nil, err
+	}
+	return attributeRows(raw), nil
+}
+
+func attributeRows(raw []rawAttribute) []AttributeRow {
+	rows := make([]AttributeRow, 0, len(raw))
+	for _, attr := range raw {
+		rows = append(rows, attributeRow(attr))
+	}
+	return rows
+}
+
+func attributeRow(attr rawAttribute) AttributeRow {
+	switch {
+	case attr.String != nil:
+		return simpleRow(attr.Name, "String", attr.String.ComputedOptionalRequired, attr.String.Description, attr.String.Default)
+	case attr.Bool != nil:
+		return simpleRow(attr.Name, "Boolean", attr.Bool.ComputedOptionalRequired, attr.Bool.Description, attr.Bool.Default)
+	case attr.Int64 != nil:
+		return simpleRow(attr.Name, "Number (int64)", attr.Int64.ComputedOptionalRequired, attr.Int64.Description, attr.Int64.Default)
+	case attr.Float64 != nil:
+		return simpleRow(attr.Name, "Number (float64)", attr.Float64.ComputedOptionalRequired, attr.Float64.Description, attr.Float64.Default)
+	case attr.Number != nil:
+		return simpleRow(attr.Name, "Number", attr.Number.ComputedOptionalRequired, attr.Number.Description, attr.Number.Default)
+	case attr.List != nil:
+		return simpleRow(attr.Name, "List", attr.List.ComputedOptionalRequired, attr.List.Description, attr.List.Default)
+	case attr.Map != nil:
+		return simpleRow(attr.Name, "Map", attr.Map.ComputedOptionalRequired, attr.Map.Description, attr.Map.Default)
+	case attr.Set != nil:
+		return simpleRow(attr.Name, "Set", attr.Set.ComputedOptionalRequired, attr.Set.Description, attr.Set.Default)
+	case attr.SingleNested != nil:
+		row := simpleRow(attr.Name, "Object", attr.SingleNested.ComputedOptionalRequired, attr.SingleNested.Description, nil)
+		row.Nested = attributeRows(attr.SingleNested.NestedObject.Attributes)
+		return row
+	case attr.ListNested != nil:
+		row := simpleRow(attr.Name, "List of Object", attr.ListNested.ComputedOptionalRequired, attr.ListNested.Description, nil)
+		row.Nested = attributeRows(attr.ListNested.NestedObject.Attributes)
+		return row
+	case attr.SetNested != nil:
+		row := simpleRow(attr.Name, "Set of Object", attr.SetNested.ComputedOptionalRequired, attr.SetNested.Description, nil)
+		row.Nested = attributeRows(attr.SetNested.NestedObject.Attributes)
+		return row
+	default:
+		return AttributeRow{Name: attr.Name, Type: "Unknown"}
+	}
+}
+
+func simpleRow(name, typeLabel, computedOptionalRequired string, description *string, def *rawDefault) AttributeRow {
+	row := AttributeRow{
+		Name: name,
+		Type: typeLabel,
+		Mode: computedOptionalRequired,
+	}
+	if description != nil {
+		row.Description = *description
+	}
+	if def != nil && len(def.Static) > 0 {
+		row.Default = string(def.Static)
+	}
+	return row
+}
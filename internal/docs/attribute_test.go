@@ -0,0 +1,63 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package docs
+
+import "testing"
+
+func TestExtractAttributes(t *testing.T) {
+	attributesJSON := []byte(`[
+		{
+			"name": "id",
+			"string": {
+				"computed_optional_required": "computed",
+				"description": "the widget's identifier"
+			}
+		},
+		{
+			"name": "tags",
+			"list": {
+				"computed_optional_required": "optional"
+			}
+		},
+		{
+			"name": "owner",
+			"single_nested": {
+				"computed_optional_required": "required",
+				"nested_object": {
+					"attributes": [
+						{
+							"name": "email",
+							"string": {
+								"computed_optional_required": "required"
+							}
+						}
+					]
+				}
+			}
+		}
+	]`)
+
+	rows, err := ExtractAttributes(attributesJSON)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if len(rows) != 3 {
+		t.Fatalf("expected 3 attribute rows, got %d", len(rows))
+	}
+
+	if rows[0].Name != "id" || rows[0].Type != "String" || rows[0].Mode != "computed" || rows[0].Description != "the widget's identifier" {
+		t.Errorf("unexpected row for 'id': %+v", rows[0])
+	}
+
+	if rows[1].Name != "tags" || rows[1].Type != "List" || rows[1].Mode != "optional" {
+		t.Errorf("unexpected row for 'tags': %+v", rows[1])
+	}
+
+	if rows[2].Name != "owner" || rows[2].Type != "Object" {
+		t.Errorf("unexpected row for 'owner': %+v", rows[2])
+	}
+	if len(rows[2].Nested) != 1 || rows[2].Nested[0].Name != "email" {
+		t.Errorf("expected 'owner' to have a nested 'email' row, got: %+v", rows[2].Nested)
+	}
+}
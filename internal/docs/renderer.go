@@ -0,0 +1,200 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package docs renders Markdown reference pages (provider index, resources,
+// data sources) from a generated Framework IR, following the page layout
+// conventions of terraform-plugin-docs.
+package docs
+
+import (
+	"bytes"
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"text/template"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/datasource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/resource"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+)
+
+//go:embed templates/*.md.tmpl
+var defaultTemplatesFS embed.FS
+
+const (
+	resourceTemplateName   = "resource.md.tmpl"
+	dataSourceTemplateName = "data_source.md.tmpl"
+	providerTemplateName   = "provider.md.tmpl"
+	indexTemplateName      = "index.md.tmpl"
+)
+
+// PageData is the data made available to a resource/data source/provider
+// Markdown template.
+type PageData struct {
+	Name        string
+	Description string
+	Attributes  []AttributeRow
+}
+
+// IndexData is the data made available to the provider index template.
+type IndexData struct {
+	Name        string
+	Description string
+	Resources   []string
+	DataSources []string
+}
+
+// Renderer renders Markdown pages from a Framework IR, using the embedded
+// default templates unless overridden by a user-supplied templates
+// directory.
+type Renderer struct {
+	templates *template.Template
+}
+
+// NewRenderer loads the default templates, then layers any same-named
+// templates found in templatesDir on top, so a user can override a subset of
+// pages (e.g. just resource.md.tmpl) without having to supply all of them.
+func NewRenderer(templatesDir string) (*Renderer, error) {
+	tmpl, err := template.ParseFS(defaultTemplatesFS, "templates/*.md.tmpl")
+	if err != nil {
+		return nil, fmt.Errorf("error parsing default doc templates: %w", err)
+	}
+
+	if templatesDir != "" {
+		overrides, err := filepath.Glob(filepath.Join(templatesDir, "*.md.tmpl"))
+		if err != nil {
+			return nil, fmt.Errorf("error globbing templates directory %q: %w", templatesDir, err)
+		}
+		for _, override := range overrides {
+			if _, err := tmpl.ParseFiles(override); err != nil {
+				return nil, fmt.Errorf("error parsing template override %q: %w", override, err)
+			}
+		}
+	}
+
+	return &Renderer{templates: tmpl}, nil
+}
+
+// RenderResource renders a single resource Markdown page.
+func (r *Renderer) RenderResource(name string, schemaAttributesJSON []byte, description string) ([]byte, error) {
+	return r.renderPage(resourceTemplateName, name, schemaAttributesJSON, description)
+}
+
+// RenderDataSource renders a single data source Markdown page.
+func (r *Renderer) RenderDataSource(name string, schemaAttributesJSON []byte, description string) ([]byte, error) {
+	return r.renderPage(dataSourceTemplateName, name, schemaAttributesJSON, description)
+}
+
+// RenderProvider renders the provider Markdown page.
+func (r *Renderer) RenderProvider(name string, schemaAttributesJSON []byte, description string) ([]byte, error) {
+	return r.renderPage(providerTemplateName, name, schemaAttributesJSON, description)
+}
+
+// RenderIndex renders the provider index Markdown page.
+func (r *Renderer) RenderIndex(data IndexData) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := r.templates.ExecuteTemplate(buf, indexTemplateName, data); err != nil {
+		return nil, fmt.Errorf("error executing %s: %w", indexTemplateName, err)
+	}
+	return buf.Bytes(), nil
+}
+
+func (r *Renderer) renderPage(templateName, name string, schemaAttributesJSON []byte, description string) ([]byte, error) {
+	attrs, err := ExtractAttributes(schemaAttributesJSON)
+	if err != nil {
+		return nil, fmt.Errorf("error extracting attributes for %q: %w", name, err)
+	}
+
+	buf := &bytes.Buffer{}
+	data := PageData{Name: name, Description: description, Attributes: attrs}
+	if err := r.templates.ExecuteTemplate(buf, templateName, data); err != nil {
+		return nil, fmt.Errorf("error executing %s for %q: %w", templateName, name, err)
+	}
+
+	return buf.Bytes(), nil
+}
+
+// WriteAll renders every resource, data source, and provider page from
+// specification and writes them under outputDir using the
+// terraform-plugin-docs layout: docs/resources/*.md, docs/data-sources/*.md,
+// docs/index.md.
+func WriteAll(r *Renderer, specification *spec.Specification, outputDir string) error {
+	resourcesDir := filepath.Join(outputDir, "resources")
+	dataSourcesDir := filepath.Join(outputDir, "data-sources")
+	if err := os.MkdirAll(resourcesDir, 0o755); err != nil {
+		return fmt.Errorf("error creating resources docs directory: %w", err)
+	}
+	if err := os.MkdirAll(dataSourcesDir, 0o755); err != nil {
+		return fmt.Errorf("error creating data sources docs directory: %w", err)
+	}
+
+	var resourceNames, dataSourceNames []string
+
+	for _, res := range specification.Resources {
+		if res.Schema == nil {
+			continue
+		}
+
+		attrsJSON, err := json.Marshal(res.Schema.Attributes)
+		if err != nil {
+			return fmt.Errorf("error marshalling attributes for resource %q: %w", res.Name, err)
+		}
+		page, err := r.RenderResource(res.Name, attrsJSON, resourceDescription(res))
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(resourcesDir, res.Name+".md"), page, 0o644); err != nil {
+			return fmt.Errorf("error writing doc page for resource %q: %w", res.Name, err)
+		}
+		resourceNames = append(resourceNames, res.Name)
+	}
+
+	for _, ds := range specification.DataSources {
+		if ds.Schema == nil {
+			continue
+		}
+
+		attrsJSON, err := json.Marshal(ds.Schema.Attributes)
+		if err != nil {
+			return fmt.Errorf("error marshalling attributes for data source %q: %w", ds.Name, err)
+		}
+		page, err := r.RenderDataSource(ds.Name, attrsJSON, dataSourceDescription(ds))
+		if err != nil {
+			return err
+		}
+		if err := os.WriteFile(filepath.Join(dataSourcesDir, ds.Name+".md"), page, 0o644); err != nil {
+			return fmt.Errorf("error writing doc page for data source %q: %w", ds.Name, err)
+		}
+		dataSourceNames = append(dataSourceNames, ds.Name)
+	}
+
+	index, err := r.RenderIndex(IndexData{
+		Name:        specification.Provider.Name,
+		Resources:   resourceNames,
+		DataSources: dataSourceNames,
+	})
+	if err != nil {
+		return err
+	}
+	if err := os.WriteFile(filepath.Join(outputDir, "index.md"), index, 0o644); err != nil {
+		return fmt.Errorf("error writing provider index doc page: %w", err)
+	}
+
+	return nil
+}
+
+func resourceDescription(r resource.Resource) string {
+	if r.Schema == nil || r.Schema.Description == nil {
+		return ""
+	}
+	return *r.Schema.Description
+}
+
+func dataSourceDescription(d datasource.DataSource) string {
+	if d.Schema == nil || d.Schema.Description == nil {
+		return ""
+	}
+	return *d.Schema.Description
+}
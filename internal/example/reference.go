@@ -0,0 +1,45 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package example
+
+import (
+	"fmt"
+	"os"
+
+	"gopkg.in/yaml.v3"
+)
+
+// ReferenceMap declares, per resource/data source attribute, that the
+// attribute's example value should be an HCL reference into another
+// generated resource's ID attribute rather than a placeholder literal - e.g.
+// for provider "acme", "widget.parent_id" -> "folder.id" produces
+// `parent_id = acme_folder.example.id`.
+//
+// Keys and values are both "<resource name>.<attribute name>".
+type ReferenceMap map[string]string
+
+// LoadReferenceMap reads a ReferenceMap from a YAML file. An empty path
+// returns an empty, non-nil map so callers don't need a nil check.
+func LoadReferenceMap(path string) (ReferenceMap, error) {
+	if path == "" {
+		return ReferenceMap{}, nil
+	}
+
+	refBytes, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read references file: %w", err)
+	}
+
+	refs := ReferenceMap{}
+	if err := yaml.Unmarshal(refBytes, &refs); err != nil {
+		return nil, fmt.Errorf("failed to parse references file: %w", err)
+	}
+
+	return refs, nil
+}
+
+func (r ReferenceMap) lookup(resourceName, attributeName string) (target string, ok bool) {
+	target, ok = r[fmt.Sprintf("%s.%s", resourceName, attributeName)]
+	return target, ok
+}
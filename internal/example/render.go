@@ -0,0 +1,176 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package example generates sample .tf HCL for generated resources and data
+// sources, so a provider author can go from OpenAPI straight to an
+// apply-able example manifest set, in the spirit of terrajet's example
+// pipeline.
+package example
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/docs"
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+)
+
+// idAttribute is the attribute name assumed to hold a resource's identifier
+// when resolving cross-resource References.
+const idAttribute = "id"
+
+// WriteAll renders one .tf file per resource and data source in
+// specification into outputDir, honoring refs for cross-resource
+// References.
+func WriteAll(specification *spec.Specification, refs ReferenceMap, outputDir string) error {
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return fmt.Errorf("error creating examples directory: %w", err)
+	}
+
+	for _, res := range specification.Resources {
+		if res.Schema == nil {
+			continue
+		}
+
+		attrsJSON, err := json.Marshal(res.Schema.Attributes)
+		if err != nil {
+			return fmt.Errorf("error marshalling attributes for resource %q: %w", res.Name, err)
+		}
+
+		hcl, err := renderBlock("resource", specification.Provider.Name, res.Name, attrsJSON, refs)
+		if err != nil {
+			return fmt.Errorf("error rendering example for resource %q: %w", res.Name, err)
+		}
+
+		if err := os.WriteFile(filepath.Join(outputDir, res.Name+".tf"), hcl, 0o644); err != nil {
+			return fmt.Errorf("error writing example for resource %q: %w", res.Name, err)
+		}
+	}
+
+	for _, ds := range specification.DataSources {
+		if ds.Schema == nil {
+			continue
+		}
+
+		attrsJSON, err := json.Marshal(ds.Schema.Attributes)
+		if err != nil {
+			return fmt.Errorf("error marshalling attributes for data source %q: %w", ds.Name, err)
+		}
+
+		hcl, err := renderBlock("data", specification.Provider.Name, ds.Name, attrsJSON, refs)
+		if err != nil {
+			return fmt.Errorf("error rendering example for data source %q: %w", ds.Name, err)
+		}
+
+		if err := os.WriteFile(filepath.Join(outputDir, ds.Name+".tf"), hcl, 0o644); err != nil {
+			return fmt.Errorf("error writing example for data source %q: %w", ds.Name, err)
+		}
+	}
+
+	return nil
+}
+
+// renderBlock renders a single `resource "<provider>_<name>" "example" { ... }`
+// or `data "<provider>_<name>" "example" { ... }` block.
+func renderBlock(blockType, providerName, name string, attributesJSON []byte, refs ReferenceMap) ([]byte, error) {
+	attrs, err := docs.ExtractAttributes(attributesJSON)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceType := fmt.Sprintf("%s_%s", providerName, name)
+
+	sb := &strings.Builder{}
+	fmt.Fprintf(sb, "%s %q %q {\n", blockType, resourceType, "example")
+	writeAttributeLines(sb, providerName, name, attrs, refs, 1)
+	sb.WriteString("}\n")
+
+	return []byte(sb.String()), nil
+}
+
+func writeAttributeLines(sb *strings.Builder, providerName, resourceName string, attrs []docs.AttributeRow, refs ReferenceMap, depth int) {
+	indent := strings.Repeat("  ", depth)
+
+	for _, attr := range attrs {
+		// Computed-only attributes are populated by the provider after
+		// apply; they have no business in a minimal example manifest.
+		if attr.Mode == "computed" {
+			continue
+		}
+
+		if target, ok := refs.lookup(resourceName, attr.Name); ok {
+			fmt.Fprintf(sb, "%s%s = %s_%s\n", indent, attr.Name, providerName, referenceExpression(target))
+			continue
+		}
+
+		if len(attr.Nested) > 0 {
+			// single_nested/list_nested/set_nested are Framework *attributes*,
+			// not schema Blocks - they need HCL assignment syntax (an object
+			// or tuple-of-one-object expression), not block syntax, or the
+			// generated example won't parse.
+			switch attr.Type {
+			case "List of Object", "Set of Object":
+				fmt.Fprintf(sb, "%s%s = [{\n", indent, attr.Name)
+				writeAttributeLines(sb, providerName, resourceName, attr.Nested, refs, depth+1)
+				fmt.Fprintf(sb, "%s}]\n", indent)
+			default:
+				fmt.Fprintf(sb, "%s%s = {\n", indent, attr.Name)
+				writeAttributeLines(sb, providerName, resourceName, attr.Nested, refs, depth+1)
+				fmt.Fprintf(sb, "%s}\n", indent)
+			}
+			continue
+		}
+
+		fmt.Fprintf(sb, "%s%s = %s\n", indent, attr.Name, placeholderValue(attr))
+	}
+}
+
+// referenceExpression turns a "<resource>.<attribute>" target into
+// "<resource>.example.<attribute>", defaulting to the ID attribute when the
+// target omits one. writeAttributeLines prefixes it with "<provider>_" so it
+// references that resource's example instance.
+func referenceExpression(target string) string {
+	parts := strings.SplitN(target, ".", 2)
+	resourceName := parts[0]
+	attributeName := idAttribute
+	if len(parts) == 2 {
+		attributeName = parts[1]
+	}
+	return fmt.Sprintf("%s.example.%s", resourceName, attributeName)
+}
+
+// placeholderValue returns an example value for attr: its OAS `default`
+// (the only one of `example`/`default`/`enum` that survives OAS-to-IR
+// mapping as a real attribute value, via ResourceConfig.Defaults/apply.go's
+// "default.static") when present, falling back to a type-appropriate stub
+// literal otherwise.
+func placeholderValue(attr docs.AttributeRow) string {
+	if attr.Default != "" {
+		return attr.Default
+	}
+	return placeholderStub(attr.Type)
+}
+
+// placeholderStub returns a type-appropriate stub literal for an attribute
+// with no default to fall back on.
+func placeholderStub(typeLabel string) string {
+	switch typeLabel {
+	case "Boolean":
+		return "true"
+	case "Number (int64)", "Number (float64)", "Number":
+		return "0"
+	case "List", "List of Object":
+		return "[]"
+	case "Set", "Set of Object":
+		return "[]"
+	case "Map":
+		return "{}"
+	case "Object":
+		return "{}"
+	default:
+		return `"example-value"`
+	}
+}
@@ -0,0 +1,74 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package example
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/docs"
+)
+
+func TestPlaceholderStub(t *testing.T) {
+	tests := map[string]string{
+		"Boolean":          "true",
+		"Number (int64)":   "0",
+		"Number (float64)": "0",
+		"Number":           "0",
+		"List":             "[]",
+		"List of Object":   "[]",
+		"Set":              "[]",
+		"Set of Object":    "[]",
+		"Map":              "{}",
+		"Object":           "{}",
+		"String":           `"example-value"`,
+		"Unknown":          `"example-value"`,
+	}
+
+	for typeLabel, want := range tests {
+		if got := placeholderStub(typeLabel); got != want {
+			t.Errorf("placeholderStub(%q) = %q, want %q", typeLabel, got, want)
+		}
+	}
+}
+
+func TestPlaceholderValuePrefersDefault(t *testing.T) {
+	withDefault := docs.AttributeRow{Type: "String", Default: `"active"`}
+	if got := placeholderValue(withDefault); got != `"active"` {
+		t.Errorf("placeholderValue() = %q, want the OAS default %q", got, `"active"`)
+	}
+
+	withoutDefault := docs.AttributeRow{Type: "String"}
+	if got := placeholderValue(withoutDefault); got != `"example-value"` {
+		t.Errorf("placeholderValue() = %q, want the type stub %q", got, `"example-value"`)
+	}
+}
+
+func TestReferenceExpression(t *testing.T) {
+	tests := map[string]string{
+		"folder":           "folder.example.id",
+		"folder.name":      "folder.example.name",
+		"widget.parent_id": "widget.example.parent_id",
+	}
+
+	for target, want := range tests {
+		if got := referenceExpression(target); got != want {
+			t.Errorf("referenceExpression(%q) = %q, want %q", target, got, want)
+		}
+	}
+}
+
+func TestReferenceMapLookup(t *testing.T) {
+	refs := ReferenceMap{
+		"widget.parent_id": "folder.id",
+	}
+
+	target, ok := refs.lookup("widget", "parent_id")
+	if !ok || target != "folder.id" {
+		t.Fatalf("expected lookup to find 'widget.parent_id', got target=%q ok=%v", target, ok)
+	}
+
+	if _, ok := refs.lookup("widget", "missing"); ok {
+		t.Fatal("expected lookup for 'widget.missing' to fail")
+	}
+}
@@ -0,0 +1,72 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package explorer
+
+import (
+	"fmt"
+
+	v3high "github.com/pb33f/libopenapi/datamodel/high/v3"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+)
+
+// functionExtension is the OAS operation extension used to opt an operation
+// into function mapping without an explicit `functions:` config entry.
+const functionExtension = "x-terraform-function"
+
+// Function is the explorer's resolved view of a single OAS operation chosen
+// to become a Terraform provider-defined function.
+type Function struct {
+	Name          string
+	Operation     *v3high.Operation
+	SchemaOptions config.FunctionSchemaOptions
+}
+
+// FindFunctions resolves the `functions:` config section, plus any operation
+// tagged with the `x-terraform-function` extension, against the OAS model.
+func (e ConfigExplorer) FindFunctions() (map[string]Function, error) {
+	functions := map[string]Function{}
+
+	for name, functionCfg := range e.config.Functions {
+		operation, err := e.findOperation(functionCfg.Invoke)
+		if err != nil {
+			return nil, fmt.Errorf("error finding function '%s': %w", name, err)
+		}
+
+		functions[name] = Function{
+			Name:          name,
+			Operation:     operation,
+			SchemaOptions: functionCfg.SchemaOptions,
+		}
+	}
+
+	for _, pathItem := range e.doc.Paths.PathItems.FromOldest() {
+		for _, operation := range []*v3high.Operation{
+			pathItem.Get, pathItem.Put, pathItem.Post, pathItem.Delete,
+			pathItem.Options, pathItem.Head, pathItem.Patch, pathItem.Trace,
+		} {
+			if operation == nil {
+				continue
+			}
+			if operation.Extensions == nil {
+				continue
+			}
+			if _, ok := operation.Extensions.Get(functionExtension); !ok {
+				continue
+			}
+
+			name := operation.OperationId
+			if _, claimed := functions[name]; claimed {
+				continue
+			}
+
+			functions[name] = Function{
+				Name:      name,
+				Operation: operation,
+			}
+		}
+	}
+
+	return functions, nil
+}
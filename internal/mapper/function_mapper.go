@@ -0,0 +1,235 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package mapper
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/function"
+	"github.com/pb33f/libopenapi/datamodel/high/base"
+
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/config"
+	"github.com/hashicorp/terraform-plugin-codegen-openapi/internal/explorer"
+)
+
+// FunctionMapper maps explorer-resolved OAS operations to provider-defined
+// function IR, mirroring ResourceMapper/DataSourceMapper.
+type FunctionMapper struct {
+	functions map[string]explorer.Function
+	cfg       config.Config
+}
+
+func NewFunctionMapper(functions map[string]explorer.Function, cfg config.Config) FunctionMapper {
+	return FunctionMapper{
+		functions: functions,
+		cfg:       cfg,
+	}
+}
+
+// MapToIR maps each resolved function into the Framework IR function.Function
+// shape: one Parameter per request parameter and JSON request body property,
+// and a Return derived from the success response schema.
+func (m FunctionMapper) MapToIR() ([]function.Function, error) {
+	functions := []function.Function{}
+
+	for name, explorerFunction := range m.functions {
+		parameters, err := m.mapParameters(explorerFunction)
+		if err != nil {
+			return nil, fmt.Errorf("error mapping parameters for function '%s': %w", name, err)
+		}
+
+		functionReturn, err := m.mapReturn(explorerFunction)
+		if err != nil {
+			return nil, fmt.Errorf("error mapping return for function '%s': %w", name, err)
+		}
+
+		functionResult := function.Function{
+			Name:       name,
+			Parameters: parameters,
+			Return:     functionReturn,
+		}
+
+		if explorerFunction.Operation.Summary != "" {
+			summary := explorerFunction.Operation.Summary
+			functionResult.Summary = &summary
+		}
+		if explorerFunction.Operation.Description != "" {
+			description := explorerFunction.Operation.Description
+			functionResult.Description = &description
+		}
+
+		functions = append(functions, functionResult)
+	}
+
+	return functions, nil
+}
+
+func (m FunctionMapper) mapParameters(explorerFunction explorer.Function) ([]function.Parameter, error) {
+	parameters := []function.Parameter{}
+
+	ignored := map[string]bool{}
+	for _, name := range explorerFunction.SchemaOptions.IgnoredParameters {
+		ignored[name] = true
+	}
+
+	for _, oasParam := range explorerFunction.Operation.Parameters {
+		if ignored[oasParam.Name] {
+			continue
+		}
+		if oasParam.Schema == nil {
+			continue
+		}
+
+		schema, err := oasParam.Schema.BuildSchema()
+		if err != nil {
+			return nil, fmt.Errorf("error building schema for parameter '%s': %w", oasParam.Name, err)
+		}
+
+		param, err := schemaToParameter(oasParam.Name, schema)
+		if err != nil {
+			return nil, err
+		}
+
+		parameters = append(parameters, param)
+	}
+
+	bodyParameters, err := m.mapRequestBodyParameters(explorerFunction, ignored)
+	if err != nil {
+		return nil, err
+	}
+	parameters = append(parameters, bodyParameters...)
+
+	return parameters, nil
+}
+
+// mapRequestBodyParameters maps the JSON request body's object properties
+// into one function.Parameter per property. parse/lookup/validate-style
+// operations are virtually always invoked with a JSON body rather than query
+// params, so skipping the request body here would leave those functions with
+// no usable parameters at all.
+func (m FunctionMapper) mapRequestBodyParameters(explorerFunction explorer.Function, ignored map[string]bool) ([]function.Parameter, error) {
+	requestBody := explorerFunction.Operation.RequestBody
+	if requestBody == nil {
+		return nil, nil
+	}
+
+	mediaType := requestBody.Content.GetOrZero("application/json")
+	if mediaType == nil || mediaType.Schema == nil {
+		return nil, nil
+	}
+
+	schema, err := mediaType.Schema.BuildSchema()
+	if err != nil {
+		return nil, fmt.Errorf("error building schema for request body: %w", err)
+	}
+
+	if schemaType(schema) != "object" {
+		return nil, nil
+	}
+
+	parameters := []function.Parameter{}
+	for propName, propProxy := range schema.Properties.FromOldest() {
+		if ignored[propName] {
+			continue
+		}
+
+		propSchema, err := propProxy.BuildSchema()
+		if err != nil {
+			return nil, fmt.Errorf("error building schema for request body property '%s': %w", propName, err)
+		}
+
+		param, err := schemaToParameter(propName, propSchema)
+		if err != nil {
+			return nil, err
+		}
+
+		parameters = append(parameters, param)
+	}
+
+	return parameters, nil
+}
+
+func (m FunctionMapper) mapReturn(explorerFunction explorer.Function) (function.Return, error) {
+	responses := explorerFunction.Operation.Responses
+	if responses == nil {
+		return function.StringReturn{}, nil
+	}
+
+	response := responses.Codes.GetOrZero("200")
+	if response == nil {
+		return function.StringReturn{}, nil
+	}
+
+	mediaType := response.Content.GetOrZero("application/json")
+	if mediaType == nil || mediaType.Schema == nil {
+		return function.StringReturn{}, nil
+	}
+
+	schema, err := mediaType.Schema.BuildSchema()
+	if err != nil {
+		return nil, fmt.Errorf("error building schema for response: %w", err)
+	}
+
+	return schemaToReturn(schema)
+}
+
+func schemaToParameter(name string, schema *base.Schema) (function.Parameter, error) {
+	switch schemaType(schema) {
+	case "string":
+		return function.StringParameter{Name: name}, nil
+	case "boolean":
+		return function.BoolParameter{Name: name}, nil
+	case "integer":
+		return function.Int64Parameter{Name: name}, nil
+	case "number":
+		return function.Float64Parameter{Name: name}, nil
+	default:
+		return function.StringParameter{Name: name}, nil
+	}
+}
+
+func schemaToReturn(schema *base.Schema) (function.Return, error) {
+	switch schemaType(schema) {
+	case "string":
+		return function.StringReturn{}, nil
+	case "boolean":
+		return function.BoolReturn{}, nil
+	case "integer":
+		return function.Int64Return{}, nil
+	case "number":
+		return function.Float64Return{}, nil
+	case "object":
+		attributeTypes := []function.ObjectAttributeType{}
+		for propName, propProxy := range schema.Properties.FromOldest() {
+			propSchema, err := propProxy.BuildSchema()
+			if err != nil {
+				return nil, fmt.Errorf("error building schema for property '%s': %w", propName, err)
+			}
+			attributeTypes = append(attributeTypes, objectAttributeType(propName, propSchema))
+		}
+		return function.ObjectReturn{AttributeTypes: attributeTypes}, nil
+	default:
+		return function.StringReturn{}, nil
+	}
+}
+
+func objectAttributeType(name string, schema *base.Schema) function.ObjectAttributeType {
+	switch schemaType(schema) {
+	case "boolean":
+		return function.BoolAttributeType{Name: name}
+	case "integer":
+		return function.Int64AttributeType{Name: name}
+	case "number":
+		return function.Float64AttributeType{Name: name}
+	default:
+		return function.StringAttributeType{Name: name}
+	}
+}
+
+func schemaType(schema *base.Schema) string {
+	if schema == nil || len(schema.Type) == 0 {
+		return "string"
+	}
+	return schema.Type[0]
+}
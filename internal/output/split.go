@@ -0,0 +1,213 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+// Package output writes a generated Framework IR to disk split into one file
+// per resource, data source, and function plus an index.json manifest - so
+// large, multi-hundred-resource providers
+// stay diff-friendly in code review and can be regenerated incrementally.
+package output
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"github.com/hashicorp/terraform-plugin-codegen-spec/spec"
+)
+
+// manifestFileName is the incremental-mode index written alongside a split
+// output directory.
+const manifestFileName = "index.json"
+
+// Manifest is the content of index.json: where each entry of a split
+// specification was written, and a content hash of the Framework IR that
+// produced it, so a later incremental run can tell which entries changed.
+type Manifest struct {
+	Provider    Entry            `json:"provider"`
+	Resources   map[string]Entry `json:"resources,omitempty"`
+	DataSources map[string]Entry `json:"data_sources,omitempty"`
+	Functions   map[string]Entry `json:"functions,omitempty"`
+}
+
+// Entry records where a single specification entry was written, relative to
+// the output directory, and a hash of the Framework IR content that produced
+// it.
+type Entry struct {
+	File string `json:"file"`
+	Hash string `json:"hash"`
+}
+
+// WriteSplit writes specification under outputDir as provider.json,
+// resources/<name>.json, data-sources/<name>.json, and (if any are present)
+// functions/<name>.json, plus an index.json manifest, instead of a single
+// monolithic JSON file.
+//
+// When incremental is true, WriteSplit reads the manifest left by the
+// previous run and leaves any entry whose Framework IR content hash is
+// unchanged untouched on disk, so reviewers and downstream framework-codegen
+// only see the entries that actually changed.
+func WriteSplit(specification *spec.Specification, outputDir string, incremental bool) error {
+	prior := &Manifest{}
+	if incremental {
+		m, err := readManifest(outputDir)
+		if err != nil {
+			return fmt.Errorf("error reading prior manifest: %w", err)
+		}
+		if m != nil {
+			prior = m
+		}
+	}
+
+	manifest := Manifest{
+		Resources:   map[string]Entry{},
+		DataSources: map[string]Entry{},
+	}
+
+	var err error
+	manifest.Provider, err = writeEntry(outputDir, "provider.json", specification.Provider, prior.Provider.Hash)
+	if err != nil {
+		return fmt.Errorf("error writing provider: %w", err)
+	}
+
+	for _, res := range specification.Resources {
+		relPath := filepath.Join("resources", res.Name+".json")
+		entry, err := writeEntry(outputDir, relPath, res, prior.Resources[res.Name].Hash)
+		if err != nil {
+			return fmt.Errorf("error writing resource %q: %w", res.Name, err)
+		}
+		manifest.Resources[res.Name] = entry
+	}
+
+	for _, ds := range specification.DataSources {
+		relPath := filepath.Join("data-sources", ds.Name+".json")
+		entry, err := writeEntry(outputDir, relPath, ds, prior.DataSources[ds.Name].Hash)
+		if err != nil {
+			return fmt.Errorf("error writing data source %q: %w", ds.Name, err)
+		}
+		manifest.DataSources[ds.Name] = entry
+	}
+
+	if len(specification.Functions) > 0 {
+		manifest.Functions = map[string]Entry{}
+		for _, fn := range specification.Functions {
+			relPath := filepath.Join("functions", fn.Name+".json")
+			entry, err := writeEntry(outputDir, relPath, fn, prior.Functions[fn.Name].Hash)
+			if err != nil {
+				return fmt.Errorf("error writing function %q: %w", fn.Name, err)
+			}
+			manifest.Functions[fn.Name] = entry
+		}
+	}
+
+	if err := removeOrphans(outputDir, prior, manifest); err != nil {
+		return fmt.Errorf("error removing orphaned entries: %w", err)
+	}
+
+	return writeManifest(outputDir, manifest)
+}
+
+// removeOrphans deletes the file for every entry present in prior but no
+// longer present in manifest - a resource, data source, or function removed
+// from the spec since the last run - so stale output doesn't linger
+// untracked by the new index.json.
+func removeOrphans(outputDir string, prior *Manifest, manifest Manifest) error {
+	orphans := append(
+		append(
+			diffEntries(prior.Resources, manifest.Resources),
+			diffEntries(prior.DataSources, manifest.DataSources)...,
+		),
+		diffEntries(prior.Functions, manifest.Functions)...,
+	)
+
+	for _, entry := range orphans {
+		if err := os.Remove(filepath.Join(outputDir, entry.File)); err != nil && !os.IsNotExist(err) {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffEntries returns the entries in prior whose key is absent from current.
+func diffEntries(prior, current map[string]Entry) []Entry {
+	var orphans []Entry
+	for name, entry := range prior {
+		if _, ok := current[name]; !ok {
+			orphans = append(orphans, entry)
+		}
+	}
+	return orphans
+}
+
+// writeEntry marshals value as indented JSON and writes it to
+// filepath.Join(outputDir, relPath), unless its hash matches priorHash and
+// the file is still present on disk, in which case it's left untouched.
+func writeEntry(outputDir, relPath string, value interface{}, priorHash string) (Entry, error) {
+	contentJSON, err := json.MarshalIndent(value, "", "\t")
+	if err != nil {
+		return Entry{}, fmt.Errorf("error marshalling %q to JSON: %w", relPath, err)
+	}
+
+	hash := hashBytes(contentJSON)
+	fullPath := filepath.Join(outputDir, relPath)
+
+	if hash == priorHash {
+		if _, err := os.Stat(fullPath); err == nil {
+			return Entry{File: relPath, Hash: hash}, nil
+		}
+	}
+
+	if err := os.MkdirAll(filepath.Dir(fullPath), 0o755); err != nil {
+		return Entry{}, fmt.Errorf("error creating directory for %q: %w", relPath, err)
+	}
+	if err := os.WriteFile(fullPath, contentJSON, 0o644); err != nil {
+		return Entry{}, fmt.Errorf("error writing %q: %w", relPath, err)
+	}
+
+	return Entry{File: relPath, Hash: hash}, nil
+}
+
+func hashBytes(b []byte) string {
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
+
+// readManifest reads the prior index.json from outputDir, returning a nil
+// Manifest (and no error) if this is the first run.
+func readManifest(outputDir string) (*Manifest, error) {
+	manifestBytes, err := os.ReadFile(filepath.Join(outputDir, manifestFileName))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+
+	var manifest Manifest
+	if err := json.Unmarshal(manifestBytes, &manifest); err != nil {
+		return nil, fmt.Errorf("error parsing prior manifest: %w", err)
+	}
+
+	return &manifest, nil
+}
+
+func writeManifest(outputDir string, manifest Manifest) error {
+	if err := os.MkdirAll(outputDir, 0o755); err != nil {
+		return fmt.Errorf("error creating output directory: %w", err)
+	}
+
+	manifestJSON, err := json.MarshalIndent(manifest, "", "\t")
+	if err != nil {
+		return fmt.Errorf("error marshalling manifest: %w", err)
+	}
+
+	if err := os.WriteFile(filepath.Join(outputDir, manifestFileName), manifestJSON, 0o644); err != nil {
+		return fmt.Errorf("error writing manifest: %w", err)
+	}
+
+	return nil
+}
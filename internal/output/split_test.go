@@ -0,0 +1,89 @@
+// Copyright (c) HashiCorp, Inc.
+// SPDX-License-Identifier: MPL-2.0
+
+package output
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+// staticTime is an arbitrary fixed timestamp used to detect whether
+// writeEntry rewrote a file, without relying on the wall clock.
+var staticTime = time.Date(2020, time.January, 1, 0, 0, 0, 0, time.UTC)
+
+func TestHashBytesIsDeterministic(t *testing.T) {
+	a := hashBytes([]byte(`{"name":"widget"}`))
+	b := hashBytes([]byte(`{"name":"widget"}`))
+	if a != b {
+		t.Fatalf("expected identical content to hash identically, got %q and %q", a, b)
+	}
+
+	c := hashBytes([]byte(`{"name":"gadget"}`))
+	if a == c {
+		t.Fatal("expected different content to hash differently")
+	}
+}
+
+func TestWriteEntrySkipsUnchangedContent(t *testing.T) {
+	dir := t.TempDir()
+
+	first, err := writeEntry(dir, "resources/widget.json", map[string]string{"name": "widget"}, "")
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	path := filepath.Join(dir, "resources/widget.json")
+	if err := os.Chtimes(path, staticTime, staticTime); err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+
+	second, err := writeEntry(dir, "resources/widget.json", map[string]string{"name": "widget"}, first.Hash)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if second.Hash != first.Hash {
+		t.Fatalf("expected hash to be stable across an unchanged write, got %q then %q", first.Hash, second.Hash)
+	}
+
+	info, err := os.Stat(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if !info.ModTime().Equal(staticTime) {
+		t.Fatal("expected writeEntry to leave an unchanged file untouched on disk")
+	}
+
+	third, err := writeEntry(dir, "resources/widget.json", map[string]string{"name": "gadget"}, first.Hash)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if third.Hash == first.Hash {
+		t.Fatal("expected changed content to produce a different hash")
+	}
+
+	info, err = os.Stat(path)
+	if err != nil {
+		t.Fatalf("unexpected error: %s", err)
+	}
+	if info.ModTime().Equal(staticTime) {
+		t.Fatal("expected writeEntry to rewrite the file when content changed")
+	}
+}
+
+func TestDiffEntriesFindsOrphans(t *testing.T) {
+	prior := map[string]Entry{
+		"widget": {File: "resources/widget.json", Hash: "a"},
+		"gadget": {File: "resources/gadget.json", Hash: "b"},
+	}
+	current := map[string]Entry{
+		"widget": {File: "resources/widget.json", Hash: "a"},
+	}
+
+	orphans := diffEntries(prior, current)
+	if len(orphans) != 1 || orphans[0].File != "resources/gadget.json" {
+		t.Fatalf("expected exactly the 'gadget' entry to be orphaned, got: %+v", orphans)
+	}
+}